@@ -0,0 +1,68 @@
+// Package font provides a small bitmap font shared by panel/metrics and panel/notify for
+// rendering text on flipdot displays.
+package font
+
+// Glyph is a 3-wide, 5-tall bitmap character. Each element is one row, using bits 2..0 for
+// the left, middle and right columns (bit set means dot on).
+type Glyph [5]byte
+
+const (
+	Width  = 3
+	Height = 5
+)
+
+// glyphs is a minimal 3x5 bitmap font covering digits, uppercase letters and a handful of
+// punctuation marks, enough for numeric readouts, short labels and scrolling alert text.
+var glyphs = map[rune]Glyph{
+	' ': {0b000, 0b000, 0b000, 0b000, 0b000},
+	'0': {0b111, 0b101, 0b101, 0b101, 0b111},
+	'1': {0b010, 0b110, 0b010, 0b010, 0b111},
+	'2': {0b111, 0b001, 0b111, 0b100, 0b111},
+	'3': {0b111, 0b001, 0b111, 0b001, 0b111},
+	'4': {0b101, 0b101, 0b111, 0b001, 0b001},
+	'5': {0b111, 0b100, 0b111, 0b001, 0b111},
+	'6': {0b111, 0b100, 0b111, 0b101, 0b111},
+	'7': {0b111, 0b001, 0b001, 0b001, 0b001},
+	'8': {0b111, 0b101, 0b111, 0b101, 0b111},
+	'9': {0b111, 0b101, 0b111, 0b001, 0b111},
+	'A': {0b111, 0b101, 0b111, 0b101, 0b101},
+	'B': {0b110, 0b101, 0b110, 0b101, 0b110},
+	'C': {0b111, 0b100, 0b100, 0b100, 0b111},
+	'D': {0b110, 0b101, 0b101, 0b101, 0b110},
+	'E': {0b111, 0b100, 0b111, 0b100, 0b111},
+	'F': {0b111, 0b100, 0b111, 0b100, 0b100},
+	'G': {0b111, 0b100, 0b101, 0b101, 0b111},
+	'H': {0b101, 0b101, 0b111, 0b101, 0b101},
+	'I': {0b111, 0b010, 0b010, 0b010, 0b111},
+	'J': {0b001, 0b001, 0b001, 0b101, 0b111},
+	'K': {0b101, 0b101, 0b110, 0b101, 0b101},
+	'L': {0b100, 0b100, 0b100, 0b100, 0b111},
+	'M': {0b101, 0b111, 0b111, 0b101, 0b101},
+	'N': {0b101, 0b111, 0b111, 0b111, 0b101},
+	'O': {0b111, 0b101, 0b101, 0b101, 0b111},
+	'P': {0b111, 0b101, 0b111, 0b100, 0b100},
+	'Q': {0b111, 0b101, 0b101, 0b111, 0b001},
+	'R': {0b111, 0b101, 0b110, 0b101, 0b101},
+	'S': {0b111, 0b100, 0b111, 0b001, 0b111},
+	'T': {0b111, 0b010, 0b010, 0b010, 0b010},
+	'U': {0b101, 0b101, 0b101, 0b101, 0b111},
+	'V': {0b101, 0b101, 0b101, 0b101, 0b010},
+	'W': {0b101, 0b101, 0b111, 0b111, 0b101},
+	'X': {0b101, 0b101, 0b010, 0b101, 0b101},
+	'Y': {0b101, 0b101, 0b111, 0b010, 0b010},
+	'Z': {0b111, 0b001, 0b010, 0b100, 0b111},
+	'!': {0b010, 0b010, 0b010, 0b000, 0b010},
+	'.': {0b000, 0b000, 0b000, 0b000, 0b010},
+	',': {0b000, 0b000, 0b000, 0b010, 0b100},
+	'-': {0b000, 0b000, 0b111, 0b000, 0b000},
+	':': {0b000, 0b010, 0b000, 0b010, 0b000},
+	'%': {0b101, 0b001, 0b010, 0b100, 0b101},
+}
+
+// Lookup returns the bitmap for r, falling back to a blank cell for unsupported runes.
+func Lookup(r rune) Glyph {
+	if g, ok := glyphs[r]; ok {
+		return g
+	}
+	return glyphs[' ']
+}
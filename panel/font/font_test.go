@@ -0,0 +1,15 @@
+package font
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLookupFallsBackToBlankForUnsupportedRune(t *testing.T) {
+	assert.Equal(t, glyphs[' '], Lookup('漢'))
+}
+
+func TestLookupReturnsKnownGlyph(t *testing.T) {
+	assert.Equal(t, glyphs['A'], Lookup('A'))
+}
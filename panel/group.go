@@ -0,0 +1,153 @@
+package panel
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"image"
+	"log"
+)
+
+// PanelCoord identifies a single panel within a Group and a local coordinate on that panel.
+type PanelCoord struct {
+	PanelIndex int
+	X, Y       int
+}
+
+// GroupLayout maps a global coordinate on the wall to the panel (and local coordinate)
+// responsible for displaying it. Locate returns ok == false when no panel covers (gx, gy).
+type GroupLayout interface {
+	Locate(gx, gy int) (PanelCoord, bool)
+}
+
+// GridLayout maps global coordinates onto a regular grid of equally-sized panels, tiled
+// left-to-right, top-to-bottom, Cols panels per row.
+type GridLayout struct {
+	Cols                    int
+	PanelWidth, PanelHeight int
+}
+
+// Locate implements GroupLayout for a regular grid of panels. GridLayout has no row count, so
+// a coordinate below the last row of panels still returns ok == true, with a PanelIndex past
+// the end of the Group's Panels; Group.Set skips such coordinates.
+func (gl GridLayout) Locate(gx, gy int) (PanelCoord, bool) {
+	if gl.Cols <= 0 || gl.PanelWidth <= 0 || gl.PanelHeight <= 0 || gx < 0 || gy < 0 {
+		return PanelCoord{}, false
+	}
+	col := gx / gl.PanelWidth
+	row := gy / gl.PanelHeight
+	if col >= gl.Cols {
+		return PanelCoord{}, false
+	}
+	return PanelCoord{
+		PanelIndex: row*gl.Cols + col,
+		X:          gx % gl.PanelWidth,
+		Y:          gy % gl.PanelHeight,
+	}, true
+}
+
+// MapLayout maps global coordinates to panels explicitly, for walls that aren't a regular grid.
+type MapLayout map[[2]int]PanelCoord
+
+// Locate implements GroupLayout by looking the coordinate up directly.
+func (ml MapLayout) Locate(gx, gy int) (PanelCoord, bool) {
+	coord, ok := ml[[2]int{gx, gy}]
+	return coord, ok
+}
+
+// Group orchestrates multiple addressed Panels daisy-chained on a single serial bus, so a
+// whole wall of panels can be updated and flipped together.
+type Group struct {
+	Port   SerialPortI
+	Panels []*Panel
+	Layout GroupLayout
+
+	Width  int // logical width of the whole wall, in dots
+	Height int // logical height of the whole wall, in dots
+
+	dirty []bool // per-panel: true if the panel has unsent changes
+}
+
+// NewGroup returns a Group of size w x h tiled over panels according to layout. Every panel
+// is pointed at port, so Panels should each carry a distinct Address.
+func NewGroup(port SerialPortI, layout GroupLayout, w, h int, panels ...*Panel) *Group {
+	for _, p := range panels {
+		p.Port = port
+	}
+	return &Group{
+		Port:   port,
+		Panels: panels,
+		Layout: layout,
+		Width:  w,
+		Height: h,
+		dirty:  make([]bool, len(panels)),
+	}
+}
+
+// Set the given global coordinate dot on or off, routing to whichever panel covers it.
+func (g *Group) Set(gx, gy int, state bool) {
+	coord, ok := g.Layout.Locate(gx, gy)
+	if !ok || coord.PanelIndex < 0 || coord.PanelIndex >= len(g.Panels) {
+		log.Printf("WARNING: Skipping Group.Set() with (%d, %d) not mapped to any panel", gx, gy)
+		return
+	}
+	p := g.Panels[coord.PanelIndex]
+	if p.Get(coord.X, coord.Y) == state {
+		return
+	}
+	p.Set(coord.X, coord.Y, state)
+	g.dirty[coord.PanelIndex] = true
+}
+
+// Clear the dots on or off across every panel in the group.
+func (g *Group) Clear(state bool) {
+	for i, p := range g.Panels {
+		p.Clear(state)
+		g.dirty[i] = true
+	}
+}
+
+// DrawImage sets each dot of the wall from img, thresholding luminance to black and white.
+func (g *Group) DrawImage(img image.Image) {
+	bounds := img.Bounds()
+	for y := 0; y < g.Height && y < bounds.Dy(); y++ {
+		for x := 0; x < g.Width && x < bounds.Dx(); x++ {
+			r, gr, b, _ := img.At(bounds.Min.X+x, bounds.Min.Y+y).RGBA()
+			lum := 0.299*float64(r) + 0.587*float64(gr) + 0.114*float64(b)
+			g.Set(x, y, lum > 0x7fff)
+		}
+	}
+}
+
+// Send queues the current state on every panel that changed since the last Send, then emits
+// one broadcast refresh so the whole wall flips at once.
+func (g *Group) Send(ctx context.Context) error {
+	for i, p := range g.Panels {
+		if !g.dirty[i] {
+			continue
+		}
+		p.Queue(ctx)
+		g.dirty[i] = false
+	}
+	return g.Refresh(ctx)
+}
+
+// Refresh broadcasts the show command (address 0xff) so every panel displays its queued data.
+func (g *Group) Refresh(ctx context.Context) error {
+	frame := []byte{0x80, 0x82, 0xff, 0x8f}
+
+	if g.Port == nil {
+		log.Printf("Message: %x", frame)
+		return nil
+	}
+
+	n, err := writeWithContext(ctx, g.Port, frame, 0)
+	if err != nil {
+		return errors.New(fmt.Sprintf("couldn't write broadcast refresh to port: %s", err))
+	}
+	if n != len(frame) {
+		return errors.New(fmt.Sprintf("Didn't send all bytes to the board, expected %d bytes, got %d bytes", len(frame), n))
+	}
+
+	return nil
+}
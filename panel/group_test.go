@@ -0,0 +1,134 @@
+package panel
+
+import (
+	"context"
+	"image"
+	"image/color"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeSerialPort records every byte slice written to it, for asserting on wire frames.
+type fakeSerialPort struct {
+	writes [][]byte
+}
+
+func (f *fakeSerialPort) Write(p []byte) (int, error) {
+	cp := make([]byte, len(p))
+	copy(cp, p)
+	f.writes = append(f.writes, cp)
+	return len(p), nil
+}
+
+func (f *fakeSerialPort) Flush() error { return nil }
+func (f *fakeSerialPort) Close() error { return nil }
+
+func newTestGroup(port SerialPortI) *Group {
+	left := &Panel{Address: []byte{0x01}, Width: 7, Height: 7, State: make(State, 7)}
+	right := &Panel{Address: []byte{0x02}, Width: 7, Height: 7, State: make(State, 7)}
+	for i := range left.State {
+		left.State[i] = make([]bool, 7)
+		right.State[i] = make([]bool, 7)
+	}
+
+	layout := GridLayout{Cols: 2, PanelWidth: 7, PanelHeight: 7}
+	return NewGroup(port, layout, 14, 7, left, right)
+}
+
+func TestGroupSetRoutesToCorrectPanel(t *testing.T) {
+	g := newTestGroup(&fakeSerialPort{})
+
+	g.Set(0, 0, true)
+	g.Set(8, 1, true)
+
+	assert.True(t, g.Panels[0].Get(0, 0))
+	assert.True(t, g.Panels[1].Get(1, 1))
+	assert.False(t, g.Panels[0].Get(1, 1))
+}
+
+func TestGroupSetOutOfBoundsIsSkipped(t *testing.T) {
+	g := newTestGroup(&fakeSerialPort{})
+
+	g.Set(-1, 0, true)
+	g.Set(100, 100, true)
+
+	for _, p := range g.Panels {
+		for x := 0; x < p.Width; x++ {
+			for y := 0; y < p.Height; y++ {
+				assert.False(t, p.Get(x, y))
+			}
+		}
+	}
+}
+
+func TestGroupSendOnlyQueuesDirtyPanels(t *testing.T) {
+	port := &fakeSerialPort{}
+	g := newTestGroup(port)
+
+	g.Set(0, 0, true) // only touches the left panel
+
+	err := g.Send(context.Background())
+	assert.NoError(t, err)
+
+	// one queue frame for the dirty left panel, plus the broadcast refresh
+	assert.Len(t, port.writes, 2)
+	assert.Equal(t, byte(0x01), port.writes[0][2]) // left panel's address
+	assert.Equal(t, []byte{0x80, 0x82, 0xff, 0x8f}, port.writes[1])
+
+	// a second Send with no new changes should only emit the broadcast refresh
+	err = g.Send(context.Background())
+	assert.NoError(t, err)
+	assert.Len(t, port.writes, 3)
+	assert.Equal(t, []byte{0x80, 0x82, 0xff, 0x8f}, port.writes[2])
+}
+
+func TestGroupDrawImageThresholdsLuminance(t *testing.T) {
+	g := newTestGroup(&fakeSerialPort{})
+
+	img := image.NewGray(image.Rect(0, 0, 2, 2))
+	img.SetGray(0, 0, color.Gray{Y: 255}) // white
+	img.SetGray(1, 0, color.Gray{Y: 0})   // black
+	img.SetGray(0, 1, color.Gray{Y: 0})   // black
+	img.SetGray(1, 1, color.Gray{Y: 255}) // white
+
+	g.DrawImage(img)
+
+	assert.True(t, g.Panels[0].Get(0, 0))
+	assert.False(t, g.Panels[0].Get(1, 0))
+	assert.False(t, g.Panels[0].Get(0, 1))
+	assert.True(t, g.Panels[0].Get(1, 1))
+}
+
+func TestGroupDrawImageHandlesNonZeroOrigin(t *testing.T) {
+	g := newTestGroup(&fakeSerialPort{})
+
+	// A sub-image whose Bounds() don't start at (0, 0), to cover the bounds.Min.X/Y offset math.
+	img := image.NewGray(image.Rect(5, 5, 7, 7))
+	img.SetGray(5, 5, color.Gray{Y: 255}) // maps to wall (0, 0)
+	img.SetGray(6, 5, color.Gray{Y: 0})   // maps to wall (1, 0)
+	img.SetGray(5, 6, color.Gray{Y: 0})   // maps to wall (0, 1)
+	img.SetGray(6, 6, color.Gray{Y: 255}) // maps to wall (1, 1)
+
+	g.DrawImage(img)
+
+	assert.True(t, g.Panels[0].Get(0, 0))
+	assert.False(t, g.Panels[0].Get(1, 0))
+	assert.False(t, g.Panels[0].Get(0, 1))
+	assert.True(t, g.Panels[0].Get(1, 1))
+}
+
+func TestGroupClearMarksEveryPanelDirty(t *testing.T) {
+	port := &fakeSerialPort{}
+	g := newTestGroup(port)
+
+	g.Clear(true)
+	for _, p := range g.Panels {
+		assert.True(t, p.Get(0, 0))
+	}
+
+	err := g.Send(context.Background())
+	assert.NoError(t, err)
+	// one queue frame per panel, plus the broadcast refresh
+	assert.Len(t, port.writes, 3)
+}
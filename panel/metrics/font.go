@@ -0,0 +1,16 @@
+package metrics
+
+import "github.com/kevinawoo/flipdots/panel/font"
+
+// Glyph is a 3-wide, 5-tall bitmap character, shared with panel/notify via panel/font.
+type Glyph = font.Glyph
+
+const (
+	glyphWidth  = font.Width
+	glyphHeight = font.Height
+)
+
+// glyphFor looks up the bitmap for r, falling back to a blank cell for unsupported runes.
+func glyphFor(r rune) Glyph {
+	return font.Lookup(r)
+}
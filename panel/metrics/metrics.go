@@ -0,0 +1,170 @@
+// Package metrics periodically scrapes a Prometheus-compatible /metrics endpoint and renders
+// selected series onto a flipdot panel or Group, so a small wall can serve as a live
+// CPU/req-rate style dashboard.
+package metrics
+
+import (
+	"context"
+	"log"
+	"net/http"
+	"time"
+
+	dto "github.com/prometheus/client_model/go"
+	"github.com/prometheus/common/expfmt"
+)
+
+// Series declares one metric to scrape and where/how to render it.
+type Series struct {
+	Name   string            // Prometheus metric name
+	Labels map[string]string // label values that must match; unset keys are ignored
+
+	Mode RenderMode
+
+	X, Y, W, H int // screen region this series owns
+
+	Min, Max  float64 // scale used by RenderBar and RenderSparkline
+	Threshold float64 // used by RenderThreshold
+	Decimals  int     // used by RenderNumeric
+
+	HistorySize int     // ring buffer length, used by RenderSparkline
+	Decay       float64 // smoothing factor, used by RenderSparkline
+
+	spark *Sparkline
+}
+
+// Dashboard scrapes Endpoint every Interval and renders each Series onto Sink.
+type Dashboard struct {
+	Sink     Sink
+	Endpoint string
+	Interval time.Duration
+	Series   []*Series
+
+	Client *http.Client
+}
+
+// NewDashboard returns a Dashboard ready to Run. Series with Mode == RenderSparkline get
+// their history ring buffer initialized here.
+func NewDashboard(sink Sink, endpoint string, interval time.Duration, series ...*Series) *Dashboard {
+	for _, s := range series {
+		if s.Mode == RenderSparkline {
+			size := s.HistorySize
+			if size <= 0 {
+				size = s.W
+			}
+			s.spark = NewSparkline(size, s.Decay)
+		}
+	}
+	return &Dashboard{
+		Sink:     sink,
+		Endpoint: endpoint,
+		Interval: interval,
+		Series:   series,
+		Client:   http.DefaultClient,
+	}
+}
+
+// Run scrapes and renders on Interval until ctx is done.
+func (d *Dashboard) Run(ctx context.Context) error {
+	ticker := time.NewTicker(d.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+			if err := d.tick(ctx); err != nil {
+				log.Printf("panel/metrics: scrape of %s failed: %s", d.Endpoint, err)
+			}
+		}
+	}
+}
+
+func (d *Dashboard) tick(ctx context.Context) error {
+	families, err := d.scrape(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, s := range d.Series {
+		value, ok := lookup(families, s)
+		if !ok {
+			continue
+		}
+		s.render(d.Sink, value)
+	}
+
+	return d.Sink.Send(ctx)
+}
+
+func (d *Dashboard) scrape(ctx context.Context) (map[string]*dto.MetricFamily, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, d.Endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := d.Client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var parser expfmt.TextParser
+	return parser.TextToMetricFamilies(resp.Body)
+}
+
+// render draws value into the series' region according to its Mode.
+func (s *Series) render(sink Sink, value float64) {
+	switch s.Mode {
+	case RenderNumeric:
+		renderValue(sink, s.X, s.Y, s.W, s.H, value, s.Decimals)
+	case RenderBar:
+		renderBar(sink, s.X, s.Y, s.W, s.H, value, s.Min, s.Max)
+	case RenderThreshold:
+		renderThreshold(sink, s.X, s.Y, s.W, s.H, value, s.Threshold)
+	case RenderSparkline:
+		s.spark.Push(value)
+		renderSparkline(sink, s.X, s.Y, s.W, s.H, s.spark.Values(), s.Min, s.Max)
+	}
+}
+
+// lookup finds the first metric in families matching s.Name and s.Labels, and returns its
+// value as a float64 (gauges, counters and untyped metrics are all supported).
+func lookup(families map[string]*dto.MetricFamily, s *Series) (float64, bool) {
+	family, ok := families[s.Name]
+	if !ok {
+		return 0, false
+	}
+
+	for _, m := range family.GetMetric() {
+		if !labelsMatch(m.GetLabel(), s.Labels) {
+			continue
+		}
+		switch {
+		case m.GetGauge() != nil:
+			return m.GetGauge().GetValue(), true
+		case m.GetCounter() != nil:
+			return m.GetCounter().GetValue(), true
+		case m.GetUntyped() != nil:
+			return m.GetUntyped().GetValue(), true
+		}
+	}
+
+	return 0, false
+}
+
+func labelsMatch(pairs []*dto.LabelPair, want map[string]string) bool {
+	if len(want) == 0 {
+		return true
+	}
+	have := make(map[string]string, len(pairs))
+	for _, p := range pairs {
+		have[p.GetName()] = p.GetValue()
+	}
+	for k, v := range want {
+		if have[k] != v {
+			return false
+		}
+	}
+	return true
+}
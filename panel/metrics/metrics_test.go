@@ -0,0 +1,21 @@
+package metrics
+
+import (
+	"testing"
+
+	dto "github.com/prometheus/client_model/go"
+	"github.com/stretchr/testify/assert"
+)
+
+func labelPair(name, value string) *dto.LabelPair {
+	return &dto.LabelPair{Name: &name, Value: &value}
+}
+
+func TestLabelsMatch(t *testing.T) {
+	pairs := []*dto.LabelPair{labelPair("method", "GET"), labelPair("code", "200")}
+
+	assert.True(t, labelsMatch(pairs, nil))
+	assert.True(t, labelsMatch(pairs, map[string]string{"method": "GET"}))
+	assert.False(t, labelsMatch(pairs, map[string]string{"method": "POST"}))
+	assert.False(t, labelsMatch(pairs, map[string]string{"missing": "x"}))
+}
@@ -0,0 +1,119 @@
+package metrics
+
+import (
+	"context"
+	"fmt"
+	"math"
+)
+
+// Sink is anything a Dashboard can draw onto and flip: a single panel.Panel or a
+// panel.Group both satisfy it.
+type Sink interface {
+	Set(x, y int, state bool)
+	Send(ctx context.Context) error
+}
+
+// RenderMode selects how a Series' value is drawn into its screen region.
+type RenderMode int
+
+const (
+	// RenderNumeric draws the value as digits using the bundled bitmap font.
+	RenderNumeric RenderMode = iota
+	// RenderBar draws a horizontal bar scaled between Series.Min and Series.Max.
+	RenderBar
+	// RenderSparkline draws the Series' rolling history as a line chart.
+	RenderSparkline
+	// RenderThreshold lights the whole region on or off depending on Series.Threshold.
+	RenderThreshold
+)
+
+// clearRegion blanks every dot in x,y,w,h before a fresh render.
+func clearRegion(sink Sink, x, y, w, h int) {
+	for dx := 0; dx < w; dx++ {
+		for dy := 0; dy < h; dy++ {
+			sink.Set(x+dx, y+dy, false)
+		}
+	}
+}
+
+// renderNumeric draws text (usually a formatted value) left to right starting at x,y, one
+// glyph per glyphWidth+1 columns, clipped to the region's height.
+func renderNumeric(sink Sink, x, y, w, h int, text string) {
+	clearRegion(sink, x, y, w, h)
+
+	cursor := 0
+	for _, r := range text {
+		if cursor+glyphWidth > w {
+			break
+		}
+		g := glyphFor(r)
+		for row := 0; row < glyphHeight && row < h; row++ {
+			bits := g[row]
+			for col := 0; col < glyphWidth; col++ {
+				if bits&(1<<uint(glyphWidth-1-col)) != 0 {
+					sink.Set(x+cursor+col, y+row, true)
+				}
+			}
+		}
+		cursor += glyphWidth + 1
+	}
+}
+
+// renderValue formats value to a fixed number of decimals and draws it with renderNumeric.
+func renderValue(sink Sink, x, y, w, h int, value float64, decimals int) {
+	renderNumeric(sink, x, y, w, h, fmt.Sprintf("%.*f", decimals, value))
+}
+
+// renderBar draws a horizontal bar whose length represents value scaled between min and max.
+func renderBar(sink Sink, x, y, w, h int, value, min, max float64) {
+	clearRegion(sink, x, y, w, h)
+
+	if max <= min {
+		return
+	}
+	frac := (value - min) / (max - min)
+	frac = math.Max(0, math.Min(1, frac))
+	lit := int(math.Round(frac * float64(w)))
+
+	for dx := 0; dx < lit; dx++ {
+		for dy := 0; dy < h; dy++ {
+			sink.Set(x+dx, y+dy, true)
+		}
+	}
+}
+
+// renderThreshold lights the whole region when value crosses threshold.
+func renderThreshold(sink Sink, x, y, w, h int, value, threshold float64) {
+	on := value >= threshold
+	for dx := 0; dx < w; dx++ {
+		for dy := 0; dy < h; dy++ {
+			sink.Set(x+dx, y+dy, on)
+		}
+	}
+}
+
+// renderSparkline draws samples as a per-column bar height, most recent sample on the right.
+func renderSparkline(sink Sink, x, y, w, h int, samples []float64, min, max float64) {
+	clearRegion(sink, x, y, w, h)
+
+	if max <= min || len(samples) == 0 {
+		return
+	}
+
+	start := 0
+	if len(samples) > w {
+		start = len(samples) - w
+	}
+	visible := samples[start:]
+
+	offset := w - len(visible)
+	for i, v := range visible {
+		frac := (v - min) / (max - min)
+		frac = math.Max(0, math.Min(1, frac))
+		lit := int(math.Round(frac * float64(h)))
+		col := offset + i
+		for dy := 0; dy < lit; dy++ {
+			sink.Set(x+col, y+h-1-dy, true)
+		}
+	}
+}
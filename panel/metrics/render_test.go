@@ -0,0 +1,85 @@
+package metrics
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeSink records Set calls on a small grid, for asserting on render output.
+type fakeSink struct {
+	w, h int
+	grid [][]bool
+	sent int
+}
+
+func newFakeSink(w, h int) *fakeSink {
+	grid := make([][]bool, w)
+	for x := range grid {
+		grid[x] = make([]bool, h)
+	}
+	return &fakeSink{w: w, h: h, grid: grid}
+}
+
+func (f *fakeSink) Set(x, y int, state bool) {
+	if x < 0 || x >= f.w || y < 0 || y >= f.h {
+		return
+	}
+	f.grid[x][y] = state
+}
+
+func (f *fakeSink) Send(ctx context.Context) error {
+	f.sent++
+	return nil
+}
+
+func (f *fakeSink) litCount() int {
+	n := 0
+	for _, col := range f.grid {
+		for _, v := range col {
+			if v {
+				n++
+			}
+		}
+	}
+	return n
+}
+
+func TestRenderBarScalesToWidth(t *testing.T) {
+	sink := newFakeSink(10, 3)
+
+	renderBar(sink, 0, 0, 10, 3, 5, 0, 10)
+
+	assert.True(t, sink.grid[4][0])
+	assert.False(t, sink.grid[5][0])
+}
+
+func TestRenderThresholdTogglesWholeRegion(t *testing.T) {
+	sink := newFakeSink(4, 4)
+
+	renderThreshold(sink, 0, 0, 4, 4, 90, 80)
+	assert.Equal(t, 16, sink.litCount())
+
+	renderThreshold(sink, 0, 0, 4, 4, 70, 80)
+	assert.Equal(t, 0, sink.litCount())
+}
+
+func TestRenderNumericDrawsSomething(t *testing.T) {
+	sink := newFakeSink(20, glyphHeight)
+
+	renderValue(sink, 0, 0, 20, glyphHeight, 42, 0)
+
+	assert.True(t, sink.litCount() > 0)
+}
+
+func TestRenderSparklineUsesMostRecentSamples(t *testing.T) {
+	sink := newFakeSink(3, 5)
+
+	// width is 3, so only the last 3 of these 4 samples should be drawn
+	renderSparkline(sink, 0, 0, 3, 5, []float64{0, 5, 10, 10}, 0, 10)
+
+	assert.False(t, sink.grid[0][0]) // sample 5 reaches mid-height, not the top row
+	assert.True(t, sink.grid[0][4])  // ...but does light the bottom row
+	assert.True(t, sink.grid[2][0])  // most recent sample (10) fills the whole column
+}
@@ -0,0 +1,50 @@
+package metrics
+
+// Sparkline is a fixed-size ring buffer of recent samples, smoothed by an exponential decay
+// so a single noisy scrape doesn't make the trace jump around.
+type Sparkline struct {
+	samples []float64
+	pos     int
+	filled  bool
+	decay   float64 // 0 disables smoothing, closer to 1 weights history more heavily
+	last    float64
+	hasLast bool
+}
+
+// NewSparkline returns a Sparkline holding up to size samples, smoothing new pushes toward
+// the running value by decay (0 <= decay < 1).
+func NewSparkline(size int, decay float64) *Sparkline {
+	if size < 1 {
+		size = 1
+	}
+	return &Sparkline{
+		samples: make([]float64, size),
+		decay:   decay,
+	}
+}
+
+// Push records a new sample, applying the configured decay against the previous sample.
+func (s *Sparkline) Push(v float64) {
+	if s.hasLast {
+		v = s.decay*s.last + (1-s.decay)*v
+	}
+	s.last = v
+	s.hasLast = true
+
+	s.samples[s.pos] = v
+	s.pos = (s.pos + 1) % len(s.samples)
+	if s.pos == 0 {
+		s.filled = true
+	}
+}
+
+// Values returns the buffered samples in oldest-to-newest order.
+func (s *Sparkline) Values() []float64 {
+	if !s.filled {
+		return append([]float64(nil), s.samples[:s.pos]...)
+	}
+	ordered := make([]float64, 0, len(s.samples))
+	ordered = append(ordered, s.samples[s.pos:]...)
+	ordered = append(ordered, s.samples[:s.pos]...)
+	return ordered
+}
@@ -0,0 +1,30 @@
+package metrics
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSparklineValuesOrderedOldestFirst(t *testing.T) {
+	s := NewSparkline(3, 0)
+
+	s.Push(1)
+	s.Push(2)
+	s.Push(3)
+
+	assert.Equal(t, []float64{1, 2, 3}, s.Values())
+
+	s.Push(4) // wraps, dropping the oldest sample
+	assert.Equal(t, []float64{2, 3, 4}, s.Values())
+}
+
+func TestSparklineDecaySmoothsNewSamples(t *testing.T) {
+	s := NewSparkline(2, 0.5)
+
+	s.Push(0)
+	s.Push(10)
+
+	values := s.Values()
+	assert.Equal(t, 5.0, values[1]) // 0.5*0 + 0.5*10
+}
@@ -0,0 +1,16 @@
+package notify
+
+import "github.com/kevinawoo/flipdots/panel/font"
+
+// glyph is a 3-wide, 5-tall bitmap character, shared with panel/metrics via panel/font.
+type glyph = font.Glyph
+
+const (
+	glyphWidth  = font.Width
+	glyphHeight = font.Height
+)
+
+// glyphFor looks up the bitmap for r, falling back to a blank cell for unsupported runes.
+func glyphFor(r rune) glyph {
+	return font.Lookup(r)
+}
@@ -0,0 +1,42 @@
+package notify
+
+import "strings"
+
+// wordWrap splits message into lines no longer than maxCols characters, breaking on spaces
+// where possible. A single word longer than maxCols is hard-broken.
+func wordWrap(message string, maxCols int) []string {
+	if maxCols <= 0 {
+		return []string{message}
+	}
+
+	var lines []string
+	for _, word := range strings.Fields(message) {
+		for len(word) > maxCols {
+			lines = appendWord(lines, word[:maxCols], maxCols)
+			word = word[maxCols:]
+		}
+		lines = appendWord(lines, word, maxCols)
+	}
+
+	if len(lines) == 0 {
+		lines = []string{""}
+	}
+	return lines
+}
+
+// appendWord adds word to the last line if it fits, otherwise starts a new line.
+func appendWord(lines []string, word string, maxCols int) []string {
+	if len(lines) == 0 {
+		return []string{word}
+	}
+	last := lines[len(lines)-1]
+	if last == "" {
+		lines[len(lines)-1] = word
+		return lines
+	}
+	if len(last)+1+len(word) <= maxCols {
+		lines[len(lines)-1] = last + " " + word
+		return lines
+	}
+	return append(lines, word)
+}
@@ -0,0 +1,17 @@
+package notify
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWordWrap(t *testing.T) {
+	lines := wordWrap("DISK ALMOST FULL", 8)
+	assert.Equal(t, []string{"DISK", "ALMOST", "FULL"}, lines)
+}
+
+func TestWordWrapHardBreaksLongWords(t *testing.T) {
+	lines := wordWrap("SUPERCALIFRAGILISTIC", 6)
+	assert.Equal(t, []string{"SUPERC", "ALIFRA", "GILIST", "IC"}, lines)
+}
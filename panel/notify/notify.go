@@ -0,0 +1,252 @@
+// Package notify displays alerts from pluggable sources (SMS/email webhooks, desktop
+// notification bridges, plain HTTP posts, ...) on a flipdot panel as a scrolling marquee,
+// flashing the board to draw attention before showing higher-severity alerts first.
+package notify
+
+import (
+	"container/heap"
+	"context"
+	"sync"
+	"time"
+)
+
+// Severity orders alerts for preemption: a higher severity interrupts a lower one.
+type Severity int
+
+const (
+	SeverityInfo Severity = iota
+	SeverityWarning
+	SeverityCritical
+)
+
+// Alert is one message to display.
+type Alert struct {
+	Source   string
+	Message  string
+	Severity Severity
+
+	receivedAt time.Time
+}
+
+// NewAlert returns an Alert stamped with the current time, used for dedup.
+func NewAlert(source, message string, severity Severity) Alert {
+	return Alert{Source: source, Message: message, Severity: severity, receivedAt: time.Now()}
+}
+
+func (a Alert) dedupKey() string {
+	return a.Source + "|" + a.Message
+}
+
+// queueItem wraps an Alert with an insertion sequence so the priority queue can fall back to
+// FIFO ordering between alerts of equal severity.
+type queueItem struct {
+	alert Alert
+	seq   int
+}
+
+type alertQueue []*queueItem
+
+func (q alertQueue) Len() int { return len(q) }
+func (q alertQueue) Less(i, j int) bool {
+	if q[i].alert.Severity != q[j].alert.Severity {
+		return q[i].alert.Severity > q[j].alert.Severity
+	}
+	return q[i].seq < q[j].seq
+}
+func (q alertQueue) Swap(i, j int)       { q[i], q[j] = q[j], q[i] }
+func (q *alertQueue) Push(x interface{}) { *q = append(*q, x.(*queueItem)) }
+func (q *alertQueue) Pop() interface{} {
+	old := *q
+	n := len(old)
+	item := old[n-1]
+	*q = old[:n-1]
+	return item
+}
+
+// AlertSink renders queued alerts onto Display: an invert-flash attention pattern followed
+// by word-wrapped (if Display is tall enough) or scrolling text.
+type AlertSink struct {
+	Display       Display
+	Width, Height int
+
+	FrameRate   time.Duration // how often the marquee/flash advances
+	FlashCount  int           // number of invert-flashes before showing a new alert
+	DedupWindow time.Duration // identical alerts from the same source are dropped within this window
+	DwellTime   time.Duration // how long a word-wrapped (non-scrolling) alert stays on screen
+
+	mu       sync.Mutex
+	queue    alertQueue
+	seq      int
+	lastSeen map[string]time.Time
+}
+
+// NewAlertSink returns an AlertSink with reasonable defaults, ready for Push and Run.
+func NewAlertSink(display Display, w, h int) *AlertSink {
+	return &AlertSink{
+		Display:     display,
+		Width:       w,
+		Height:      h,
+		FrameRate:   time.Second / 8,
+		FlashCount:  3,
+		DedupWindow: time.Minute,
+		DwellTime:   2 * time.Second,
+		lastSeen:    make(map[string]time.Time),
+	}
+}
+
+// Push enqueues an alert, returning false if it was dropped as a duplicate of one already
+// seen within DedupWindow.
+func (s *AlertSink) Push(a Alert) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key := a.dedupKey()
+	if last, ok := s.lastSeen[key]; ok && a.receivedAt.Sub(last) < s.DedupWindow {
+		return false
+	}
+	s.lastSeen[key] = a.receivedAt
+
+	s.seq++
+	heap.Push(&s.queue, &queueItem{alert: a, seq: s.seq})
+	return true
+}
+
+// requeue puts an alert preempted mid-display back on the queue, behind anything of equal
+// or higher severity that's arrived since.
+func (s *AlertSink) requeue(a Alert) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.seq++
+	heap.Push(&s.queue, &queueItem{alert: a, seq: s.seq})
+}
+
+func (s *AlertSink) pop() (Alert, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.queue.Len() == 0 {
+		return Alert{}, false
+	}
+	item := heap.Pop(&s.queue).(*queueItem)
+	return item.alert, true
+}
+
+// higherPriorityWaiting reports whether an alert more severe than sev is queued, so a
+// display loop can abandon the current alert and let it be preempted.
+func (s *AlertSink) higherPriorityWaiting(sev Severity) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.queue.Len() > 0 && s.queue[0].alert.Severity > sev
+}
+
+// Run pops alerts in priority order and displays them until ctx is canceled.
+func (s *AlertSink) Run(ctx context.Context) error {
+	for {
+		a, ok := s.pop()
+		if !ok {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(s.FrameRate):
+			}
+			continue
+		}
+		if err := s.present(ctx, a); err != nil {
+			return err
+		}
+	}
+}
+
+func (s *AlertSink) present(ctx context.Context, a Alert) error {
+	if err := s.flash(ctx); err != nil {
+		return err
+	}
+
+	lines := wordWrap(a.Message, s.Width/(glyphWidth+1))
+	if len(lines)*(glyphHeight+1) <= s.Height {
+		return s.showWrapped(ctx, a, lines)
+	}
+	return s.scroll(ctx, a, a.Message)
+}
+
+// flash inverts the whole board FlashCount times to draw attention before a new alert.
+func (s *AlertSink) flash(ctx context.Context) error {
+	for i := 0; i < s.FlashCount; i++ {
+		for _, on := range [2]bool{true, false} {
+			fillRegion(s.Display, 0, 0, s.Width, s.Height, on)
+			if err := s.Display.Send(ctx); err != nil {
+				return err
+			}
+			if err := s.wait(ctx); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// showWrapped draws every line at once and holds it on screen for DwellTime, preempting itself
+// (re-queuing a for later) if a higher-severity alert arrives mid-dwell.
+func (s *AlertSink) showWrapped(ctx context.Context, a Alert, lines []string) error {
+	clearRegion(s.Display, 0, 0, s.Width, s.Height)
+	for i, line := range lines {
+		drawText(s.Display, 0, i*(glyphHeight+1), s.Width, line)
+	}
+	if err := s.Display.Send(ctx); err != nil {
+		return err
+	}
+
+	deadline := time.Now().Add(s.DwellTime)
+	for {
+		if s.higherPriorityWaiting(a.Severity) {
+			s.requeue(a)
+			return nil
+		}
+
+		remaining := time.Until(deadline)
+		if remaining <= 0 {
+			return nil
+		}
+		tick := s.FrameRate
+		if tick <= 0 || tick > remaining {
+			tick = remaining
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(tick):
+		}
+	}
+}
+
+// scroll animates text right-to-left across the board, preempting itself (re-queuing a for
+// later) if a higher-severity alert arrives mid-scroll.
+func (s *AlertSink) scroll(ctx context.Context, a Alert, text string) error {
+	width := textWidth(text)
+	for x := s.Width; x > -width; x-- {
+		clearRegion(s.Display, 0, 0, s.Width, s.Height)
+		drawText(s.Display, x, 0, s.Width, text)
+		if err := s.Display.Send(ctx); err != nil {
+			return err
+		}
+
+		if s.higherPriorityWaiting(a.Severity) {
+			s.requeue(a)
+			return nil
+		}
+
+		if err := s.wait(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *AlertSink) wait(ctx context.Context) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-time.After(s.FrameRate):
+		return nil
+	}
+}
@@ -0,0 +1,128 @@
+package notify
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeDisplay records every Set and the grid snapshot at each Send, for asserting on frame
+// sequences without any real hardware or Panel.
+type fakeDisplay struct {
+	w, h   int
+	grid   [][]bool
+	frames [][][]bool
+}
+
+func newFakeDisplay(w, h int) *fakeDisplay {
+	grid := make([][]bool, w)
+	for x := range grid {
+		grid[x] = make([]bool, h)
+	}
+	return &fakeDisplay{w: w, h: h, grid: grid}
+}
+
+func (f *fakeDisplay) Set(x, y int, state bool) {
+	if x < 0 || x >= f.w || y < 0 || y >= f.h {
+		return
+	}
+	f.grid[x][y] = state
+}
+
+func (f *fakeDisplay) Send(ctx context.Context) error {
+	snapshot := make([][]bool, len(f.grid))
+	for x, col := range f.grid {
+		snapshot[x] = append([]bool(nil), col...)
+	}
+	f.frames = append(f.frames, snapshot)
+	return nil
+}
+
+func (f *fakeDisplay) litCount(frame int) int {
+	n := 0
+	for _, col := range f.frames[frame] {
+		for _, v := range col {
+			if v {
+				n++
+			}
+		}
+	}
+	return n
+}
+
+func TestAlertSinkDedupDropsRepeatsWithinWindow(t *testing.T) {
+	sink := NewAlertSink(newFakeDisplay(10, 5), 10, 5)
+
+	a := NewAlert("sensor-1", "temp high", SeverityWarning)
+	assert.True(t, sink.Push(a))
+	assert.False(t, sink.Push(NewAlert("sensor-1", "temp high", SeverityWarning)))
+
+	assert.Equal(t, 1, sink.queue.Len())
+}
+
+func TestAlertQueuePrioritizesSeverityThenFIFO(t *testing.T) {
+	sink := NewAlertSink(newFakeDisplay(10, 5), 10, 5)
+
+	sink.Push(NewAlert("a", "first info", SeverityInfo))
+	sink.Push(NewAlert("b", "first critical", SeverityCritical))
+	sink.Push(NewAlert("c", "second info", SeverityInfo))
+
+	first, ok := sink.pop()
+	assert.True(t, ok)
+	assert.Equal(t, "first critical", first.Message)
+
+	second, ok := sink.pop()
+	assert.True(t, ok)
+	assert.Equal(t, "first info", second.Message)
+
+	third, ok := sink.pop()
+	assert.True(t, ok)
+	assert.Equal(t, "second info", third.Message)
+}
+
+func TestFlashInvertsWholeBoard(t *testing.T) {
+	display := newFakeDisplay(4, 4)
+	sink := NewAlertSink(display, 4, 4)
+	sink.FrameRate = 0
+	sink.FlashCount = 2
+
+	err := sink.flash(context.Background())
+	assert.NoError(t, err)
+
+	assert.Len(t, display.frames, 4) // 2 flashes x (on, off)
+	assert.Equal(t, 16, display.litCount(0))
+	assert.Equal(t, 0, display.litCount(1))
+	assert.Equal(t, 16, display.litCount(2))
+	assert.Equal(t, 0, display.litCount(3))
+}
+
+func TestShowWrappedPreemptedByHigherSeverity(t *testing.T) {
+	sink := NewAlertSink(newFakeDisplay(10, 10), 10, 10)
+	sink.FrameRate = time.Millisecond
+	sink.DwellTime = time.Hour
+
+	low := NewAlert("a", "low", SeverityInfo)
+	done := make(chan error, 1)
+	go func() {
+		done <- sink.showWrapped(context.Background(), low, []string{"low"})
+	}()
+
+	// give showWrapped a moment to draw and enter its dwell loop before preempting it
+	time.Sleep(10 * time.Millisecond)
+	sink.Push(NewAlert("b", "critical", SeverityCritical))
+
+	select {
+	case err := <-done:
+		assert.NoError(t, err)
+	case <-time.After(time.Second):
+		t.Fatal("showWrapped did not return after being preempted")
+	}
+
+	// the low-severity alert should have been requeued behind the critical one
+	assert.Equal(t, 2, sink.queue.Len())
+	next, ok := sink.pop()
+	assert.True(t, ok)
+	assert.Equal(t, "critical", next.Message)
+}
@@ -0,0 +1,59 @@
+package notify
+
+import "context"
+
+// Display is anything an AlertSink can draw onto and flip; *panel.Panel and *panel.Group
+// both satisfy it.
+type Display interface {
+	Set(x, y int, state bool)
+	Send(ctx context.Context) error
+}
+
+// clearRegion blanks every dot in x,y,w,h.
+func clearRegion(d Display, x, y, w, h int) {
+	for dx := 0; dx < w; dx++ {
+		for dy := 0; dy < h; dy++ {
+			d.Set(x+dx, y+dy, false)
+		}
+	}
+}
+
+// fillRegion lights or blanks every dot in x,y,w,h.
+func fillRegion(d Display, x, y, w, h int, state bool) {
+	for dx := 0; dx < w; dx++ {
+		for dy := 0; dy < h; dy++ {
+			d.Set(x+dx, y+dy, state)
+		}
+	}
+}
+
+// drawText draws text starting at global column x, row y, one glyph per glyphWidth+1
+// columns. Columns outside [0, panelWidth) are simply not drawn, so callers can scroll text
+// by animating x from panelWidth down to -textWidth(text).
+func drawText(d Display, x, y, panelWidth int, text string) {
+	cursor := x
+	for _, r := range text {
+		g := glyphFor(r)
+		for row := 0; row < glyphHeight; row++ {
+			bits := g[row]
+			for col := 0; col < glyphWidth; col++ {
+				gx := cursor + col
+				if gx < 0 || gx >= panelWidth {
+					continue
+				}
+				if bits&(1<<uint(glyphWidth-1-col)) != 0 {
+					d.Set(gx, y+row, true)
+				}
+			}
+		}
+		cursor += glyphWidth + 1
+	}
+}
+
+// textWidth returns the pixel width text would occupy when drawn with drawText.
+func textWidth(text string) int {
+	if len(text) == 0 {
+		return 0
+	}
+	return len([]rune(text))*(glyphWidth+1) - 1
+}
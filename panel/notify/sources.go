@@ -0,0 +1,53 @@
+package notify
+
+import (
+	"io"
+	"net/http"
+)
+
+// PushHTTPHandler returns an http.Handler that treats the POST body as a plain-text alert
+// message, suitable for a generic webhook (e.g. an Alertmanager receiver or curl).
+func PushHTTPHandler(sink *AlertSink, severity Severity) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			http.Error(w, "POST only", http.StatusMethodNotAllowed)
+			return
+		}
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		sink.Push(NewAlert(r.RemoteAddr, string(body), severity))
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
+// PushTwilioSMSHandler returns an http.Handler compatible with a Twilio "Messaging webhook",
+// which POSTs the sender and message body as form fields "From" and "Body".
+func PushTwilioSMSHandler(sink *AlertSink) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		from := r.FormValue("From")
+		body := r.FormValue("Body")
+		sink.Push(NewAlert(from, body, SeverityWarning))
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
+// PushEmail records an alert from an already-parsed inbound email. It's meant to be called
+// from an SMTP server backend's message handler (e.g. github.com/emersion/go-smtp), which
+// owns accepting the mail session; this package only turns the result into an Alert.
+func PushEmail(sink *AlertSink, from, subject, body string) bool {
+	return sink.Push(NewAlert(from, subject+": "+body, SeverityInfo))
+}
+
+// PushDesktopNotification records an alert bridged in from the local desktop notification bus
+// (e.g. org.freedesktop.Notifications over D-Bus, as beeep listens to). The bridge itself is
+// platform-specific and lives in the caller; this package only turns the result into an Alert.
+func PushDesktopNotification(sink *AlertSink, app, title, body string) bool {
+	return sink.Push(NewAlert(app, title+": "+body, SeverityInfo))
+}
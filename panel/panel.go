@@ -1,10 +1,13 @@
 package panel
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"image/color"
 	"log"
+	"sync"
+	"time"
 
 	"github.com/tarm/serial"
 )
@@ -19,6 +22,16 @@ type Panel struct {
 	State State
 
 	Port SerialPortI
+
+	// WriteTimeout bounds how long a single write to Port may block. Zero means no bound
+	// beyond whatever ctx is passed to Send/Queue/Refresh.
+	WriteTimeout time.Duration
+}
+
+// WithWriteTimeout sets WriteTimeout and returns p, for chaining off NewPanel.
+func (p *Panel) WithWriteTimeout(d time.Duration) *Panel {
+	p.WriteTimeout = d
+	return p
 }
 
 type SerialPortI interface {
@@ -58,18 +71,19 @@ func NewPanel(w, h int, portName string, portBaud int) (*Panel, error) {
 func (p *Panel) Close() {
 	if p.Port != nil {
 		p.Port.Close()
+		stopWriterFor(p.Port)
 	}
 	p.Port = nil
 }
 
 // Send the state of the board to the associated flip dot panel and refresh
-func (p *Panel) Send() (error) {
-	return p.sendBoard(true)
+func (p *Panel) Send(ctx context.Context) error {
+	return p.sendBoard(ctx, true)
 }
 
 // Queue the state of the board to the panel, show when Refresh() is called (used for multiple panels)
-func (p *Panel) Queue() {
-	p.sendBoard(false)
+func (p *Panel) Queue(ctx context.Context) {
+	p.sendBoard(ctx, false)
 }
 
 // GetData
@@ -78,29 +92,29 @@ func (p *Panel) GetData(refresh bool) ([]byte, error){
 }
 
 // SendBulkData
-func (p *Panel) SendBulkData(data []byte) {
+func (p *Panel) SendBulkData(ctx context.Context, data []byte) {
 	for _, x := range data {
 		fmt.Printf("0x%x ", x)
 	}
 	fmt.Print("\n")
-	p.sendData(data)
+	p.sendData(ctx, data)
 }
 
-func (p *Panel) sendBoard(refresh bool) (error) {
+func (p *Panel) sendBoard(ctx context.Context, refresh bool) error {
 	data, err := p.getData(p.Address, refresh)
 	if err != nil {
 		return err
 	}
-	return p.sendData(data)
+	return p.sendData(ctx, data)
 }
 
 // Refresh causes any queued state to be displayed
-func (p *Panel) Refresh() (error){
-	data, err  := p.getData(nil, true)
-	if err != nil{
+func (p *Panel) Refresh(ctx context.Context) error {
+	data, err := p.getData(nil, true)
+	if err != nil {
 		return err
 	}
-	return p.sendData(data)
+	return p.sendData(ctx, data)
 }
 
 // Refresh causes any queued state to be displayed
@@ -186,14 +200,14 @@ func (p *Panel) getData(address []byte,refresh bool) ([]byte, error) {
 	return message, nil
 }
 
-func (p *Panel) sendData(data []byte) (error) {
+func (p *Panel) sendData(ctx context.Context, data []byte) error {
 	if p.Port == nil {
 		log.Printf("Message: %x", data)
 		p.PrintState()
 		return nil
 	}
 
-	n, err := p.Port.Write(data)
+	n, err := writeWithContext(ctx, p.Port, data, p.WriteTimeout)
 	if err != nil {
 		return errors.New(fmt.Sprintf("couldn't write to port: %s", err))
 	}
@@ -206,6 +220,93 @@ func (p *Panel) sendData(data []byte) (error) {
 	return nil
 }
 
+// writeResult is the outcome of a single port.Write, delivered back to whichever call is still
+// waiting for it (if any).
+type writeResult struct {
+	n   int
+	err error
+}
+
+// writeRequest is one write queued up for a port's writer goroutine.
+type writeRequest struct {
+	data   []byte
+	result chan writeResult
+}
+
+// portWriter is the long-lived writer goroutine's state for one SerialPortI: reqs queues writes
+// for it, stop tells it to exit once it's idle.
+type portWriter struct {
+	reqs chan *writeRequest
+	stop chan struct{}
+}
+
+// portWriters holds one portWriter per SerialPortI still in use. A write that's abandoned
+// because ctx was done or WriteTimeout elapsed just means nothing ever reads its result: the
+// writer goroutine itself isn't duplicated, since port.Write can't be interrupted and spawning a
+// fresh goroutine per call would leak one forever for every abandoned write against a wedged
+// port. stopWriterFor reclaims the goroutine once the port is Close()d.
+var portWriters sync.Map // SerialPortI -> *portWriter
+
+func writerFor(port SerialPortI) *portWriter {
+	if w, ok := portWriters.Load(port); ok {
+		return w.(*portWriter)
+	}
+	w := &portWriter{reqs: make(chan *writeRequest), stop: make(chan struct{})}
+	actual, loaded := portWriters.LoadOrStore(port, w)
+	if !loaded {
+		go runPortWriter(port, actual.(*portWriter))
+	}
+	return actual.(*portWriter)
+}
+
+func runPortWriter(port SerialPortI, w *portWriter) {
+	for {
+		select {
+		case req := <-w.reqs:
+			n, err := port.Write(req.data)
+			req.result <- writeResult{n, err}
+		case <-w.stop:
+			return
+		}
+	}
+}
+
+// stopWriterFor tells port's writer goroutine to exit once it's done with any write already in
+// flight, and forgets port so a later writerFor call for the same value starts a fresh one.
+// Without this, reconnecting repeatedly on the same underlying Port value (e.g. a long-running
+// reconnect loop) would accumulate one permanently-parked writer goroutine per cycle.
+func stopWriterFor(port SerialPortI) {
+	if w, ok := portWriters.LoadAndDelete(port); ok {
+		close(w.(*portWriter).stop)
+	}
+}
+
+// writeWithContext queues data to be written by port's writer goroutine and returns as soon as
+// ctx is done even if the write is still in flight. If timeout > 0, ctx is additionally bounded
+// by it.
+func writeWithContext(ctx context.Context, port SerialPortI, data []byte, timeout time.Duration) (int, error) {
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	req := &writeRequest{data: data, result: make(chan writeResult, 1)}
+
+	select {
+	case writerFor(port).reqs <- req:
+	case <-ctx.Done():
+		return 0, ctx.Err()
+	}
+
+	select {
+	case res := <-req.result:
+		return res.n, res.err
+	case <-ctx.Done():
+		return 0, ctx.Err()
+	}
+}
+
 // Get the state of the dot at the given coordinate as a boolean
 func (p *Panel) Get(x, y int) bool {
 	return p.State[x][y]
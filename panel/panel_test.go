@@ -38,7 +38,8 @@ func TestNewPanel(t *testing.T) {
 
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
-			p := NewPanel(test.givenWidth, test.givenHeight, test.port, test.baud)
+			p, err := NewPanel(test.givenWidth, test.givenHeight, test.port, test.baud)
+			assert.NoError(t, err)
 			defer p.Close()
 
 			assert.Truef(t, reflect.DeepEqual(p.State, test.expectedState), "Expected state to look like: \n%s\n\nGot state looking like: \n%s\n", test.expectedState, p.State)
@@ -0,0 +1,59 @@
+package panel
+
+import (
+	"context"
+	"log"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+)
+
+// defaultShutdownTimeout bounds how long OnClose may take once Run decides to shut down, so a
+// stalled port write can't block shutdown forever.
+const defaultShutdownTimeout = 5 * time.Second
+
+// RunOptions configures Run's shutdown behavior.
+type RunOptions struct {
+	// OnClose runs once ctx is canceled or a termination signal arrives, before the port is
+	// closed. Defaults to blanking the display: Clear(false) then Send(ctx).
+	OnClose func(ctx context.Context, p *Panel) error
+
+	// ShutdownTimeout bounds OnClose, independent of ctx's own deadline. Defaults to
+	// defaultShutdownTimeout when zero.
+	ShutdownTimeout time.Duration
+}
+
+// Run blocks until ctx is canceled or the process receives SIGINT, SIGTERM or SIGHUP, then
+// runs opts.OnClose (blanking the display by default, so a crash or Ctrl-C doesn't leave
+// stuck dots on real hardware) and closes p's port.
+func Run(ctx context.Context, p *Panel, opts RunOptions) error {
+	if opts.OnClose == nil {
+		opts.OnClose = func(ctx context.Context, p *Panel) error {
+			p.Clear(false)
+			return p.Send(ctx)
+		}
+	}
+	if opts.ShutdownTimeout == 0 {
+		opts.ShutdownTimeout = defaultShutdownTimeout
+	}
+
+	sigCh := make(chan os.Signal, 1)
+	signal.Notify(sigCh, syscall.SIGINT, syscall.SIGTERM, syscall.SIGHUP)
+	defer signal.Stop(sigCh)
+
+	select {
+	case <-ctx.Done():
+	case sig := <-sigCh:
+		log.Printf("panel: received %s, shutting down", sig)
+	}
+
+	// OnClose should still be able to write to the port even if ctx is the reason we're
+	// shutting down, but it must not be able to block shutdown forever.
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), opts.ShutdownTimeout)
+	defer cancel()
+
+	err := opts.OnClose(shutdownCtx, p)
+	p.Close()
+	return err
+}
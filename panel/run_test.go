@@ -0,0 +1,165 @@
+package panel
+
+import (
+	"context"
+	"runtime"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// blockingSerialPort never returns from Write until unblock is closed, to exercise write
+// cancellation.
+type blockingSerialPort struct {
+	unblock chan struct{}
+}
+
+func (b *blockingSerialPort) Write(p []byte) (int, error) {
+	<-b.unblock
+	return len(p), nil
+}
+
+func (b *blockingSerialPort) Flush() error { return nil }
+func (b *blockingSerialPort) Close() error { return nil }
+
+func TestSendUnblocksOnContextCancel(t *testing.T) {
+	p := &Panel{Width: 7, Height: 7, State: make(State, 7), Port: &blockingSerialPort{unblock: make(chan struct{})}}
+	for i := range p.State {
+		p.State[i] = make([]bool, 7)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() {
+		done <- p.Send(ctx)
+	}()
+
+	cancel()
+
+	select {
+	case err := <-done:
+		assert.ErrorContains(t, err, context.Canceled.Error())
+	case <-time.After(time.Second):
+		t.Fatal("Send did not unblock after context cancellation")
+	}
+}
+
+func TestSendUnblocksOnWriteTimeout(t *testing.T) {
+	p := &Panel{Width: 7, Height: 7, State: make(State, 7), Port: &blockingSerialPort{unblock: make(chan struct{})}}
+	for i := range p.State {
+		p.State[i] = make([]bool, 7)
+	}
+	p.WithWriteTimeout(10 * time.Millisecond)
+
+	done := make(chan error, 1)
+	go func() {
+		done <- p.Send(context.Background())
+	}()
+
+	select {
+	case err := <-done:
+		assert.ErrorContains(t, err, context.DeadlineExceeded.Error())
+	case <-time.After(time.Second):
+		t.Fatal("Send did not unblock after write timeout")
+	}
+}
+
+func TestAbandonedWritesDoNotLeakAGoroutinePerCall(t *testing.T) {
+	p := &Panel{Width: 7, Height: 7, State: make(State, 7), Port: &blockingSerialPort{unblock: make(chan struct{})}}
+	for i := range p.State {
+		p.State[i] = make([]bool, 7)
+	}
+	p.WithWriteTimeout(5 * time.Millisecond)
+
+	// Warm up: the first Send starts the port's writer goroutine and wedges it inside
+	// port.Write forever. Every Send after this one must abandon its write without spawning
+	// another goroutine.
+	assert.ErrorContains(t, p.Send(context.Background()), context.DeadlineExceeded.Error())
+
+	runtime.GC()
+	before := runtime.NumGoroutine()
+
+	for i := 0; i < 50; i++ {
+		assert.ErrorContains(t, p.Send(context.Background()), context.DeadlineExceeded.Error())
+	}
+
+	runtime.GC()
+	after := runtime.NumGoroutine()
+
+	assert.LessOrEqual(t, after, before, "abandoned writes against a stalled port leaked goroutines")
+}
+
+// workingPort is a SerialPortI that always succeeds immediately, for tests that only care about
+// writer-goroutine lifecycle rather than write behavior.
+type workingPort struct{}
+
+func (workingPort) Write(p []byte) (int, error) { return len(p), nil }
+func (workingPort) Flush() error                { return nil }
+func (workingPort) Close() error                { return nil }
+
+func TestCloseReclaimsWriterGoroutineOnReconnect(t *testing.T) {
+	port := workingPort{}
+
+	// Send once to start port's writer goroutine, then Close to reclaim it, repeatedly on the
+	// *same* port value, as a reconnect loop would. Without stopWriterFor, each cycle would leave
+	// its writer goroutine permanently parked waiting for more requests that never arrive.
+	for i := 0; i < 5; i++ {
+		p := &Panel{Width: 7, Height: 7, State: make(State, 7), Port: port}
+		for j := range p.State {
+			p.State[j] = make([]bool, 7)
+		}
+		_ = p.Send(context.Background())
+		p.Close()
+	}
+
+	// Give the last writer goroutine a moment to observe the stop signal and exit.
+	time.Sleep(10 * time.Millisecond)
+	runtime.GC()
+
+	if _, ok := portWriters.Load(port); ok {
+		t.Fatal("portWriters still holds an entry for a Close()d port")
+	}
+}
+
+// overlapDetectingPort records whether two Write calls were ever in flight at once, to verify
+// that abandoned writes (from a canceled ctx or WriteTimeout) don't run concurrently with later
+// writes to the same port.
+type overlapDetectingPort struct {
+	inFlight   int32
+	overlapped int32
+}
+
+func (o *overlapDetectingPort) Write(p []byte) (int, error) {
+	if atomic.AddInt32(&o.inFlight, 1) > 1 {
+		atomic.StoreInt32(&o.overlapped, 1)
+	}
+	time.Sleep(5 * time.Millisecond)
+	atomic.AddInt32(&o.inFlight, -1)
+	return len(p), nil
+}
+
+func (o *overlapDetectingPort) Flush() error { return nil }
+func (o *overlapDetectingPort) Close() error { return nil }
+
+func TestConcurrentSendsDoNotInterleaveWrites(t *testing.T) {
+	port := &overlapDetectingPort{}
+	p := &Panel{Width: 7, Height: 7, State: make(State, 7), Port: port}
+	for i := range p.State {
+		p.State[i] = make([]bool, 7)
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_ = p.Send(context.Background())
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, int32(0), atomic.LoadInt32(&port.overlapped))
+}
@@ -0,0 +1,65 @@
+package tuisim
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Frame is a decoded Hanover-style wire message as produced by panel.getData.
+type Frame struct {
+	Command byte
+	Address byte
+	Refresh bool // true for a "show" command, false for "queue"
+	Data    []byte
+}
+
+type commandKind struct {
+	dataLen int
+	refresh bool
+}
+
+// commandTable mirrors the command bytes panel.getData emits for each payload size.
+var commandTable = map[byte]commandKind{
+	0x81: {112, false},
+	0x82: {112, true},
+	0x86: {56, false},
+	0x85: {56, true},
+	0x84: {28, false},
+	0x83: {28, true},
+	0x93: {14, false},
+	0x92: {14, true},
+	0x88: {7, false},
+	0x87: {7, true},
+}
+
+// decodeFrame parses a single 0x80 ... 0x8f wire message into a Frame.
+func decodeFrame(raw []byte) (Frame, error) {
+	if len(raw) < 4 {
+		return Frame{}, errors.New(fmt.Sprintf("tuisim: frame too short: %x", raw))
+	}
+	if raw[0] != 0x80 || raw[len(raw)-1] != 0x8f {
+		return Frame{}, errors.New(fmt.Sprintf("tuisim: malformed frame: %x", raw))
+	}
+
+	kind, ok := commandTable[raw[1]]
+	if !ok {
+		return Frame{}, errors.New(fmt.Sprintf("tuisim: unknown command byte 0x%x", raw[1]))
+	}
+
+	body := raw[2 : len(raw)-1]
+	if len(body) == 1 {
+		// panel.Group.Refresh emits a bare broadcast refresh pulse (address, no data) to show
+		// whatever was queued, regardless of the command's usual payload size.
+		return Frame{Command: raw[1], Address: body[0], Refresh: kind.refresh, Data: []byte{}}, nil
+	}
+	if len(body) != kind.dataLen+1 {
+		return Frame{}, errors.New(fmt.Sprintf("tuisim: command 0x%x expected %d address+data bytes, got %d", raw[1], kind.dataLen+1, len(body)))
+	}
+
+	return Frame{
+		Command: raw[1],
+		Address: body[0],
+		Refresh: kind.refresh,
+		Data:    body[1:],
+	}, nil
+}
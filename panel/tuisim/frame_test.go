@@ -0,0 +1,72 @@
+package tuisim
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecodeFrame(t *testing.T) {
+	tests := []struct {
+		name string
+		raw  []byte
+
+		expected  Frame
+		expectErr bool
+	}{
+		{
+			"7-wide queue frame",
+			[]byte{0x80, 0x88, 0x01, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x8f},
+			Frame{Command: 0x88, Address: 0x01, Refresh: false, Data: []byte{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07}},
+			false,
+		},
+		{
+			"7-wide broadcast refresh frame",
+			[]byte{0x80, 0x87, 0xff, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x8f},
+			Frame{Command: 0x87, Address: 0xff, Refresh: true, Data: []byte{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07}},
+			false,
+		},
+		{
+			"too short",
+			[]byte{0x80, 0x87, 0x8f},
+			Frame{},
+			true,
+		},
+		{
+			"missing header byte",
+			[]byte{0x00, 0x87, 0xff, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x8f},
+			Frame{},
+			true,
+		},
+		{
+			"unknown command",
+			[]byte{0x80, 0xEE, 0xff, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x8f},
+			Frame{},
+			true,
+		},
+		{
+			"data length mismatch",
+			[]byte{0x80, 0x87, 0xff, 0x01, 0x02, 0x8f},
+			Frame{},
+			true,
+		},
+		{
+			"bare broadcast refresh pulse, no queued data",
+			[]byte{0x80, 0x82, 0xff, 0x8f},
+			Frame{Command: 0x82, Address: 0xff, Refresh: true, Data: []byte{}},
+			false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := decodeFrame(test.raw)
+			if test.expectErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, got)
+		})
+	}
+}
@@ -0,0 +1,44 @@
+package tuisim
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kevinawoo/flipdots/panel"
+)
+
+// TestGroupSendDecodesOnTUIPort exercises a panel.Group pointed at a TUIPort end to end,
+// covering the queue frame for the dirty panel and the data-less broadcast-refresh frame
+// Group.Refresh emits after it.
+func TestGroupSendDecodesOnTUIPort(t *testing.T) {
+	devnull, err := os.OpenFile(os.DevNull, os.O_WRONLY, 0)
+	assert.NoError(t, err)
+	defer devnull.Close()
+
+	port := &TUIPort{
+		totalWidth:  14,
+		totalHeight: 7,
+		grid:        newGrid(14, 7),
+		out:         devnull,
+		doneCh:      make(chan struct{}),
+		stopCh:      make(chan struct{}),
+	}
+	port.AddPanel(0x01, 7, 7)
+	port.AddPanel(0x02, 7, 7)
+
+	left, err := panel.NewPanel(7, 7, "", 0)
+	assert.NoError(t, err)
+	left.Address = []byte{0x01}
+
+	right, err := panel.NewPanel(7, 7, "", 0)
+	assert.NoError(t, err)
+	right.Address = []byte{0x02}
+
+	group := panel.NewGroup(port, panel.GridLayout{Cols: 2, PanelWidth: 7, PanelHeight: 7}, 14, 7, left, right)
+	group.Set(0, 0, true)
+
+	assert.NoError(t, group.Send(context.Background()))
+}
@@ -0,0 +1,334 @@
+// Package tuisim renders a flipdot wall live in a terminal, by decoding the exact
+// 0x80/command/address/data/0x8f wire frames panel.getData produces. It's meant to be
+// dropped in place of a real serial port during development and in CI/demos, e.g.:
+//
+//	p, _ := panel.NewPanel(28, 7, "", 0)
+//	p.Port = tuisim.NewTUIPort(28, 7)
+package tuisim
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+	"image/png"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+	"time"
+
+	"golang.org/x/term"
+
+	"github.com/kevinawoo/flipdots/panel"
+)
+
+var (
+	colorOn  = color.Gray{Y: 255}
+	colorOff = color.Gray{Y: 0}
+)
+
+// panelRegion is one addressed panel's slice of the terminal-rendered wall.
+type panelRegion struct {
+	address       byte
+	x             int
+	width, height int
+}
+
+// TUIPort is a fake serial port that parses real flipdot wire frames and draws them to a
+// terminal instead of hardware. It implements panel.SerialPortI.
+type TUIPort struct {
+	mu sync.Mutex
+
+	totalWidth, totalHeight int
+	regions                 []panelRegion
+	grid                    [][]bool // grid[x][y], same orientation as panel.State
+	prevGrid                [][]bool // last rendered frame, for diffed redraws
+
+	frameCount  uint64
+	framesInWin int
+	fps         float64
+	lastCommand byte
+	paused      bool
+
+	out      *os.File
+	oldState *term.State
+
+	doneCh  chan struct{}
+	stopCh  chan struct{}
+	closeMu sync.Once
+}
+
+// NewTUIPort returns a fake serial port rendering a w x h flipdot wall in the terminal. By
+// default it treats the whole surface as one panel; call AddPanel before any writes to tile
+// multiple addressed panels horizontally instead.
+func NewTUIPort(w, h int) panel.SerialPortI {
+	t := &TUIPort{
+		totalWidth:  w,
+		totalHeight: h,
+		grid:        newGrid(w, h),
+		out:         os.Stdout,
+		doneCh:      make(chan struct{}),
+		stopCh:      make(chan struct{}),
+	}
+
+	if state, err := term.MakeRaw(int(t.out.Fd())); err == nil {
+		t.oldState = state
+	}
+
+	t.draw(true)
+	go t.watchResize()
+	go t.watchKeys()
+	go t.tickFooter()
+
+	return t
+}
+
+func newGrid(w, h int) [][]bool {
+	grid := make([][]bool, w)
+	for x := range grid {
+		grid[x] = make([]bool, h)
+	}
+	return grid
+}
+
+// AddPanel registers another addressed panel, tiled immediately to the right of whatever has
+// already been added. Call this before the first Write so frames route correctly.
+func (t *TUIPort) AddPanel(address byte, width, height int) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	x := 0
+	for _, r := range t.regions {
+		x += r.width
+	}
+	t.regions = append(t.regions, panelRegion{address: address, x: x, width: width, height: height})
+
+	if x+width > t.totalWidth {
+		t.totalWidth = x + width
+	}
+	if height > t.totalHeight {
+		t.totalHeight = height
+	}
+	t.grid = newGrid(t.totalWidth, t.totalHeight)
+}
+
+// Done is closed once the user quits the simulator with 'q'.
+func (t *TUIPort) Done() <-chan struct{} {
+	return t.doneCh
+}
+
+// Write decodes a wire frame and updates the simulated wall.
+func (t *TUIPort) Write(data []byte) (int, error) {
+	frame, err := decodeFrame(data)
+	if err != nil {
+		return 0, err
+	}
+
+	t.mu.Lock()
+	t.frameCount++
+	t.framesInWin++
+	t.lastCommand = frame.Command
+	region := t.regionFor(frame)
+	if region != nil {
+		applyFrameToGrid(t.grid, *region, frame.Data)
+	}
+	t.mu.Unlock()
+
+	t.draw(false)
+
+	return len(data), nil
+}
+
+// regionFor finds which panel a frame's address belongs to, falling back to treating the
+// whole wall as a single panel if none were explicitly registered via AddPanel.
+func (t *TUIPort) regionFor(frame Frame) *panelRegion {
+	for i, r := range t.regions {
+		if r.address == frame.Address {
+			return &t.regions[i]
+		}
+	}
+	if len(t.regions) == 0 {
+		return &panelRegion{address: frame.Address, x: 0, width: t.totalWidth, height: t.totalHeight}
+	}
+	return nil
+}
+
+// applyFrameToGrid unpacks a column-major bitpacked frame payload into the grid at region's offset.
+func applyFrameToGrid(grid [][]bool, region panelRegion, data []byte) {
+	for col := 0; col < len(data) && col < region.width; col++ {
+		gx := region.x + col
+		if gx >= len(grid) {
+			continue
+		}
+		d := data[col]
+		for row := 0; row < region.height; row++ {
+			bit := (d >> uint(region.height-1-row)) & 1
+			if row < len(grid[gx]) {
+				grid[gx][row] = bit == 1
+			}
+		}
+	}
+}
+
+// Flush is a no-op; the simulator renders synchronously on Write.
+func (t *TUIPort) Flush() error { return nil }
+
+// Close restores the terminal and signals the simulator's background goroutines to stop.
+// watchResize and tickFooter both select on stopCh and exit promptly. watchKeys only checks
+// stopCh between reads, though: os.Stdin.Read blocks and can't be interrupted, so if Close is
+// called by anything other than watchKeys itself handling 'q', that goroutine stays parked on
+// the blocking read until the user's next keystroke (or stdin is closed).
+func (t *TUIPort) Close() error {
+	var err error
+	t.closeMu.Do(func() {
+		close(t.stopCh)
+		if t.oldState != nil {
+			err = term.Restore(int(t.out.Fd()), t.oldState)
+		}
+	})
+	return err
+}
+
+func (t *TUIPort) watchResize() {
+	ch := make(chan os.Signal, 1)
+	signal.Notify(ch, syscall.SIGWINCH)
+	defer signal.Stop(ch)
+
+	for {
+		select {
+		case <-t.stopCh:
+			return
+		case <-ch:
+			t.draw(true)
+		}
+	}
+}
+
+func (t *TUIPort) tickFooter() {
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-t.stopCh:
+			return
+		case <-ticker.C:
+			t.mu.Lock()
+			t.fps = float64(t.framesInWin)
+			t.framesInWin = 0
+			t.mu.Unlock()
+			t.draw(false)
+		}
+	}
+}
+
+func (t *TUIPort) watchKeys() {
+	buf := make([]byte, 1)
+	for {
+		select {
+		case <-t.stopCh:
+			return
+		default:
+		}
+
+		n, err := os.Stdin.Read(buf)
+		if err != nil || n == 0 {
+			return
+		}
+
+		switch buf[0] {
+		case 'q':
+			close(t.doneCh)
+			t.Close()
+			return
+		case ' ':
+			t.mu.Lock()
+			t.paused = !t.paused
+			t.mu.Unlock()
+			t.draw(true)
+		case 's':
+			if err := t.dumpPNG(); err != nil {
+				fmt.Fprintf(os.Stderr, "tuisim: %s\n", err)
+			}
+		}
+	}
+}
+
+// dumpPNG writes the current grid out as a black-and-white PNG for debugging.
+func (t *TUIPort) dumpPNG() error {
+	t.mu.Lock()
+	img := image.NewGray(image.Rect(0, 0, t.totalWidth, t.totalHeight))
+	for x := 0; x < t.totalWidth; x++ {
+		for y := 0; y < t.totalHeight; y++ {
+			if t.grid[x][y] {
+				img.SetGray(x, y, colorOn)
+			} else {
+				img.SetGray(x, y, colorOff)
+			}
+		}
+	}
+	t.mu.Unlock()
+
+	f, err := os.Create(fmt.Sprintf("tuisim-%d.png", time.Now().Unix()))
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	return png.Encode(f, img)
+}
+
+// draw renders the grid to the terminal. When full is false and a previous frame was already
+// drawn, only cells that changed are repositioned and redrawn via ANSI cursor addressing.
+func (t *TUIPort) draw(full bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if full {
+		fmt.Fprint(t.out, "\x1b[2J")
+		t.prevGrid = nil
+	}
+
+	if !t.paused || full {
+		if t.prevGrid == nil {
+			for y := 0; y < t.totalHeight; y++ {
+				fmt.Fprintf(t.out, "\x1b[%d;1H", y+1)
+				for x := 0; x < t.totalWidth; x++ {
+					fmt.Fprint(t.out, dotGlyph(t.grid[x][y]))
+				}
+			}
+		} else {
+			for x := 0; x < t.totalWidth; x++ {
+				for y := 0; y < t.totalHeight; y++ {
+					if t.grid[x][y] == t.prevGrid[x][y] {
+						continue
+					}
+					fmt.Fprintf(t.out, "\x1b[%d;%dH%s", y+1, x*2+1, dotGlyph(t.grid[x][y]))
+				}
+			}
+		}
+		t.prevGrid = cloneGrid(t.grid)
+	}
+
+	status := "running"
+	if t.paused {
+		status = "paused"
+	}
+	fmt.Fprintf(t.out, "\x1b[%d;1H\x1b[K%.1f fps | last cmd 0x%02x | %s | q:quit space:pause s:dump png",
+		t.totalHeight+1, t.fps, t.lastCommand, status)
+}
+
+func dotGlyph(on bool) string {
+	if on {
+		return "⚫️"
+	}
+	return "⚪️"
+}
+
+func cloneGrid(grid [][]bool) [][]bool {
+	clone := make([][]bool, len(grid))
+	for x, col := range grid {
+		clone[x] = append([]bool(nil), col...)
+	}
+	return clone
+}